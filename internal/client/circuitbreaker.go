@@ -0,0 +1,50 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker fails requests fast after a run of consecutive failures, rather than letting
+// every data source in a plan each wait out the full request timeout against a dead endpoint.
+type circuitBreaker struct {
+	mu                  sync.Mutex
+	failureThreshold    int
+	resetTimeout        time.Duration
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, resetTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		resetTimeout:     resetTimeout,
+	}
+}
+
+// Allow reports whether a request may proceed, i.e. the breaker is not currently open.
+func (b *circuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return time.Now().After(b.openUntil)
+}
+
+// RecordSuccess resets the consecutive failure count.
+func (b *circuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures = 0
+}
+
+// RecordFailure counts a failure, opening the breaker once the threshold is reached.
+func (b *circuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= b.failureThreshold {
+		b.openUntil = time.Now().Add(b.resetTimeout)
+	}
+}
@@ -1,32 +1,66 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 // Client is the Vezor API client
 type Client struct {
-	BaseURL    string
-	APIKey     string
-	HTTPClient *http.Client
+	BaseURL         string
+	APIKey          string
+	HTTPClient      *http.Client
+	MaxRetries      int
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+	RateLimiter     *rate.Limiter
+	breaker         *circuitBreaker
+}
+
+// ClientOptions configures retry, rate-limit, and timeout behavior for a Client.
+// A nil field falls back to its default in NewClient; a non-nil field (including a zero value
+// like MaxRetries: 0 to disable retries) is used as-is, so callers can distinguish "unset" from
+// "explicitly disabled".
+type ClientOptions struct {
+	MaxRetries      *int
+	RetryMinBackoff *time.Duration
+	RetryMaxBackoff *time.Duration
+	RequestTimeout  *time.Duration
+	RateLimitQPS    *float64
 }
 
 // Secret represents a secret from the API
 type Secret struct {
-	ID          string            `json:"id"`
-	KeyName     string            `json:"key_name"`
-	Value       string            `json:"value,omitempty"`
-	Description string            `json:"description,omitempty"`
-	Tags        map[string]string `json:"tags"`
-	Version     int               `json:"version"`
-	CreatedAt   string            `json:"created_at"`
-	UpdatedAt   string            `json:"updated_at"`
+	ID             string            `json:"id"`
+	KeyName        string            `json:"key_name"`
+	Value          string            `json:"value,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Tags           map[string]string `json:"tags"`
+	Version        int               `json:"version"`
+	VersionAliases map[string]string `json:"version_aliases,omitempty"`
+	CreatedAt      string            `json:"created_at"`
+	UpdatedAt      string            `json:"updated_at"`
+	Lease          *Lease            `json:"lease,omitempty"`
+}
+
+// Lease describes how long a fetched value may be cached before it should be considered stale,
+// mirroring the lease_id/lease_duration/lease_start_time/lease_renewable shape used by Vault's
+// generic_secret data source.
+type Lease struct {
+	ID              string `json:"lease_id,omitempty"`
+	DurationSeconds int    `json:"lease_duration_seconds,omitempty"`
+	StartTime       string `json:"lease_start_time,omitempty"`
+	Renewable       bool   `json:"lease_renewable,omitempty"`
 }
 
 // Group represents a group from the API
@@ -45,6 +79,7 @@ type GroupSecrets struct {
 	Tags    map[string]string `json:"tags"`
 	Secrets map[string]string `json:"secrets"`
 	Count   int               `json:"count"`
+	Lease   *Lease            `json:"lease,omitempty"`
 }
 
 // SecretsListResponse represents the response from listing secrets
@@ -58,27 +93,113 @@ type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// apiStatusError carries the HTTP status code of a failed request so retry logic can
+// classify it without re-parsing the error string.
+type apiStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiStatusError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+const (
+	defaultMaxRetries          = 3
+	defaultRetryMinBackoff     = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+	defaultRequestTimeout      = 30 * time.Second
+	defaultCircuitThreshold    = 5
+	defaultCircuitResetTimeout = 30 * time.Second
+)
+
 // NewClient creates a new Vezor API client
-func NewClient(baseURL, apiKey string) *Client {
+func NewClient(baseURL, apiKey string, opts ClientOptions) *Client {
+	maxRetries := defaultMaxRetries
+	if opts.MaxRetries != nil {
+		maxRetries = *opts.MaxRetries
+	}
+	retryMinBackoff := defaultRetryMinBackoff
+	if opts.RetryMinBackoff != nil {
+		retryMinBackoff = *opts.RetryMinBackoff
+	}
+	retryMaxBackoff := defaultRetryMaxBackoff
+	if opts.RetryMaxBackoff != nil {
+		retryMaxBackoff = *opts.RetryMaxBackoff
+	}
+	requestTimeout := defaultRequestTimeout
+	if opts.RequestTimeout != nil {
+		requestTimeout = *opts.RequestTimeout
+	}
+
+	var limiter *rate.Limiter
+	if opts.RateLimitQPS != nil && *opts.RateLimitQPS > 0 {
+		limiter = rate.NewLimiter(rate.Limit(*opts.RateLimitQPS), max(1, int(*opts.RateLimitQPS)))
+	}
+
 	return &Client{
 		BaseURL: strings.TrimRight(baseURL, "/"),
 		APIKey:  apiKey,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: requestTimeout,
 		},
+		MaxRetries:      maxRetries,
+		RetryMinBackoff: retryMinBackoff,
+		RetryMaxBackoff: retryMaxBackoff,
+		RateLimiter:     limiter,
+		breaker:         newCircuitBreaker(defaultCircuitThreshold, defaultCircuitResetTimeout),
+	}
+}
+
+// doRequest performs an HTTP request with authentication, retrying transient failures with
+// exponential backoff and full jitter, and failing fast while the circuit breaker is open.
+func (c *Client) doRequest(method, endpoint string, params url.Values, body []byte) ([]byte, http.Header, error) {
+	if !c.breaker.Allow() {
+		return nil, nil, fmt.Errorf("circuit breaker open for %s: too many consecutive failures", c.BaseURL)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(context.Background()); err != nil {
+				return nil, nil, fmt.Errorf("rate limiter wait failed: %w", err)
+			}
+		}
+
+		respBody, headers, retryAfter, err := c.doRequestOnce(method, endpoint, params, body)
+		if err == nil {
+			c.breaker.RecordSuccess()
+			return respBody, headers, nil
+		}
+
+		lastErr = err
+		if attempt == c.MaxRetries || !isRetryable(err) {
+			c.breaker.RecordFailure()
+			return nil, nil, err
+		}
+
+		time.Sleep(backoffDuration(attempt, c.RetryMinBackoff, c.RetryMaxBackoff, retryAfter))
 	}
+
+	c.breaker.RecordFailure()
+	return nil, nil, lastErr
 }
 
-// doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte, error) {
+// doRequestOnce performs a single HTTP round trip with no retry logic of its own.
+func (c *Client) doRequestOnce(method, endpoint string, params url.Values, body []byte) ([]byte, http.Header, time.Duration, error) {
 	reqURL := fmt.Sprintf("%s%s", c.BaseURL, endpoint)
 	if params != nil && len(params) > 0 {
 		reqURL = fmt.Sprintf("%s?%s", reqURL, params.Encode())
 	}
 
-	req, err := http.NewRequest(method, reqURL, nil)
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = strings.NewReader(string(body))
+	}
+
+	req, err := http.NewRequest(method, reqURL, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
@@ -86,35 +207,113 @@ func (c *Client) doRequest(method, endpoint string, params url.Values) ([]byte,
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
+		message := string(respBody)
 		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, errResp.Error)
+		if err := json.Unmarshal(respBody, &errResp); err == nil && errResp.Error != "" {
+			message = errResp.Error
+		}
+		return nil, nil, retryAfterDuration(resp.Header), &apiStatusError{StatusCode: resp.StatusCode, Message: message}
+	}
+
+	return respBody, resp.Header, 0, nil
+}
+
+// retryAfterDuration parses a Retry-After header (seconds form) as returned on 429/503 responses
+func retryAfterDuration(h http.Header) time.Duration {
+	raw := h.Get("Retry-After")
+	if raw == "" {
+		return 0
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// IsNotFound reports whether err represents an HTTP 404 response from the API, so callers can
+// distinguish "deleted out-of-band" from other failures (e.g. to drop a resource from state).
+func IsNotFound(err error) bool {
+	var statusErr *apiStatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
+// isRetryable classifies an error from doRequestOnce: network errors and 5xx are retryable,
+// 429/408/425 are retryable, and any other 4xx is terminal.
+func isRetryable(err error) bool {
+	var statusErr *apiStatusError
+	if !errors.As(err, &statusErr) {
+		// No status code means the request never got an HTTP response (network/timeout error).
+		return true
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusTooEarly:
+		return true
+	default:
+		return statusErr.StatusCode >= 500
+	}
+}
+
+// leaseFromHeaders derives lease information from the Cache-Control max-age directive and the
+// X-Vezor-Lease-* header family, for servers that haven't adopted the JSON "lease" object yet.
+func leaseFromHeaders(h http.Header) *Lease {
+	if h == nil {
+		return nil
+	}
+
+	lease := &Lease{
+		ID:        h.Get("X-Vezor-Lease-Id"),
+		StartTime: h.Get("X-Vezor-Lease-Start"),
+		Renewable: h.Get("X-Vezor-Lease-Renewable") == "true",
+	}
+
+	if cc := h.Get("Cache-Control"); cc != "" {
+		for _, directive := range strings.Split(cc, ",") {
+			directive = strings.TrimSpace(directive)
+			if maxAge, found := strings.CutPrefix(directive, "max-age="); found {
+				if seconds, err := strconv.Atoi(maxAge); err == nil {
+					lease.DurationSeconds = seconds
+				}
+			}
 		}
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
 	}
 
-	return body, nil
+	if durHeader := h.Get("X-Vezor-Lease-Duration"); durHeader != "" {
+		if seconds, err := strconv.Atoi(durHeader); err == nil {
+			lease.DurationSeconds = seconds
+		}
+	}
+
+	if lease.ID == "" && lease.DurationSeconds == 0 && lease.StartTime == "" && !lease.Renewable {
+		return nil
+	}
+
+	return lease
 }
 
-// GetSecret retrieves a secret by ID, optionally with a specific version
-func (c *Client) GetSecret(secretID string, version *int) (*Secret, error) {
+// GetSecret retrieves a secret by ID, optionally pinned to a specific version or version alias
+func (c *Client) GetSecret(secretID string, version *int, versionAlias string) (*Secret, error) {
 	endpoint := fmt.Sprintf("/api/v1/secrets/%s", secretID)
 	params := url.Values{}
 	if version != nil {
 		params.Set("version", fmt.Sprintf("%d", *version))
 	}
+	if versionAlias != "" {
+		params.Set("version_alias", versionAlias)
+	}
 
-	body, err := c.doRequest("GET", endpoint, params)
+	body, headers, err := c.doRequest("GET", endpoint, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +322,9 @@ func (c *Client) GetSecret(secretID string, version *int) (*Secret, error) {
 	if err := json.Unmarshal(body, &secret); err != nil {
 		return nil, fmt.Errorf("failed to parse secret response: %w", err)
 	}
+	if secret.Lease == nil {
+		secret.Lease = leaseFromHeaders(headers)
+	}
 
 	return &secret, nil
 }
@@ -140,7 +342,7 @@ func (c *Client) ListSecrets(tags map[string]string, search string, limit int) (
 		params.Set("limit", fmt.Sprintf("%d", limit))
 	}
 
-	body, err := c.doRequest("GET", "/api/v1/secrets", params)
+	body, _, err := c.doRequest("GET", "/api/v1/secrets", params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +355,8 @@ func (c *Client) ListSecrets(tags map[string]string, search string, limit int) (
 	return &resp, nil
 }
 
-// FindSecret finds a secret by name and tags
-func (c *Client) FindSecret(name string, tags map[string]string) (*Secret, error) {
+// FindSecret finds a secret by name and tags, optionally pinned to a specific version or version alias
+func (c *Client) FindSecret(name string, tags map[string]string, version *int, versionAlias string) (*Secret, error) {
 	// List secrets with the given tags and search for the name
 	resp, err := c.ListSecrets(tags, name, 100)
 	if err != nil {
@@ -167,7 +369,7 @@ func (c *Client) FindSecret(name string, tags map[string]string) (*Secret, error
 			// Tags must match exactly
 			if tagsMatch(s.Tags, tags) {
 				// Get the full secret with value
-				return c.GetSecret(s.ID, nil)
+				return c.GetSecret(s.ID, version, versionAlias)
 			}
 		}
 	}
@@ -179,7 +381,7 @@ func (c *Client) FindSecret(name string, tags map[string]string) (*Secret, error
 func (c *Client) GetGroup(name string) (*Group, error) {
 	endpoint := fmt.Sprintf("/api/v1/groups/%s", url.PathEscape(name))
 
-	body, err := c.doRequest("GET", endpoint, nil)
+	body, _, err := c.doRequest("GET", endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +400,7 @@ func (c *Client) PullGroupSecrets(name string) (*GroupSecrets, error) {
 	params := url.Values{}
 	params.Set("format", "json")
 
-	body, err := c.doRequest("GET", endpoint, params)
+	body, headers, err := c.doRequest("GET", endpoint, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -207,10 +409,115 @@ func (c *Client) PullGroupSecrets(name string) (*GroupSecrets, error) {
 	if err := json.Unmarshal(body, &secrets); err != nil {
 		return nil, fmt.Errorf("failed to parse group secrets response: %w", err)
 	}
+	if secrets.Lease == nil {
+		secrets.Lease = leaseFromHeaders(headers)
+	}
 
 	return &secrets, nil
 }
 
+// createSecretRequest is the JSON body for CreateSecret
+type createSecretRequest struct {
+	KeyName     string            `json:"key_name"`
+	Value       string            `json:"value"`
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+// CreateSecret creates a new secret
+func (c *Client) CreateSecret(name, value, description string, tags map[string]string) (*Secret, error) {
+	reqBody, err := json.Marshal(createSecretRequest{
+		KeyName:     name,
+		Value:       value,
+		Description: description,
+		Tags:        tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode create secret request: %w", err)
+	}
+
+	body, _, err := c.doRequest("POST", "/api/v1/secrets", nil, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret Secret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse secret response: %w", err)
+	}
+
+	return &secret, nil
+}
+
+// updateSecretRequest is the JSON body for UpdateSecret
+type updateSecretRequest struct {
+	Value       string            `json:"value"`
+	Description string            `json:"description,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+// UpdateSecret updates an existing secret, creating a new version
+func (c *Client) UpdateSecret(secretID, value, description string, tags map[string]string) (*Secret, error) {
+	endpoint := fmt.Sprintf("/api/v1/secrets/%s", secretID)
+
+	reqBody, err := json.Marshal(updateSecretRequest{
+		Value:       value,
+		Description: description,
+		Tags:        tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode update secret request: %w", err)
+	}
+
+	body, _, err := c.doRequest("PUT", endpoint, nil, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret Secret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse secret response: %w", err)
+	}
+
+	return &secret, nil
+}
+
+// DeleteSecret deletes a secret by ID
+func (c *Client) DeleteSecret(secretID string) error {
+	endpoint := fmt.Sprintf("/api/v1/secrets/%s", secretID)
+
+	_, _, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+// setVersionAliasRequest is the JSON body for SetVersionAlias
+type setVersionAliasRequest struct {
+	Version int `json:"version"`
+}
+
+// SetVersionAlias points an alias (e.g. "stable", "latest") at a specific secret version.
+// This is a metadata update and does not create a new version.
+func (c *Client) SetVersionAlias(secretID, alias string, version int) (map[string]string, error) {
+	endpoint := fmt.Sprintf("/api/v1/secrets/%s/aliases/%s", secretID, url.PathEscape(alias))
+
+	reqBody, err := json.Marshal(setVersionAliasRequest{Version: version})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode version alias request: %w", err)
+	}
+
+	body, _, err := c.doRequest("PUT", endpoint, nil, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret Secret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse secret response: %w", err)
+	}
+
+	return secret.VersionAliases, nil
+}
+
 // tagsMatch checks if secret tags contain all required tags
 func tagsMatch(secretTags, requiredTags map[string]string) bool {
 	for k, v := range requiredTags {
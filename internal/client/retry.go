@@ -0,0 +1,24 @@
+package client
+
+import (
+	"math/rand"
+	"time"
+)
+
+// backoffDuration computes the delay before the next retry attempt. A server-provided
+// Retry-After always wins; otherwise it's exponential backoff with full jitter, capped at max.
+func backoffDuration(attempt int, min, max time.Duration, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	backoff := min << attempt
+	if backoff <= 0 || backoff > max {
+		backoff = max
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dynamicValueFromJSON converts an arbitrary value produced by json.Unmarshal into a
+// types.Dynamic so it can be surfaced on a schema.DynamicAttribute, letting HCL consumers
+// index into decoded secret values (e.g. secrets_structured["config"].host) without a
+// fixed schema.
+func dynamicValueFromJSON(v interface{}) (types.Dynamic, error) {
+	value, err := attrValueFromJSON(v)
+	if err != nil {
+		return types.Dynamic{}, err
+	}
+	return types.DynamicValue(value), nil
+}
+
+func attrValueFromJSON(v interface{}) (attr.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(val)), nil
+	case string:
+		return types.StringValue(val), nil
+	case []interface{}:
+		elemValues := make([]attr.Value, 0, len(val))
+		elemTypes := make([]attr.Type, 0, len(val))
+		for _, elem := range val {
+			elemValue, err := attrValueFromJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+			elemValues = append(elemValues, elemValue)
+			elemTypes = append(elemTypes, elemValue.Type(nil))
+		}
+		tupleValue, diags := types.TupleValue(elemTypes, elemValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to build tuple value: %v", diags)
+		}
+		return tupleValue, nil
+	case map[string]interface{}:
+		attrValues := make(map[string]attr.Value, len(val))
+		attrTypes := make(map[string]attr.Type, len(val))
+		for key, elem := range val {
+			elemValue, err := attrValueFromJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+			attrValues[key] = elemValue
+			attrTypes[key] = elemValue.Type(nil)
+		}
+		objectValue, diags := types.ObjectValue(attrTypes, attrValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to build object value: %v", diags)
+		}
+		return objectValue, nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value type %T", v)
+	}
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vezor/terraform-provider-vezor/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &EphemeralGroupResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &EphemeralGroupResource{}
+var _ ephemeral.EphemeralResourceWithClose = &EphemeralGroupResource{}
+
+// EphemeralGroupResource defines the ephemeral resource implementation
+type EphemeralGroupResource struct {
+	client *client.Client
+}
+
+// EphemeralGroupResourceModel describes the ephemeral resource data model
+type EphemeralGroupResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+	Secrets     types.Map    `tfsdk:"secrets"`
+	SecretCount types.Int64  `tfsdk:"secret_count"`
+}
+
+// ephemeralGroupPrivateData is persisted between Open and Renew so Renew can re-pull
+// without the caller re-supplying the config.
+type ephemeralGroupPrivateData struct {
+	Name string `json:"name"`
+}
+
+// NewEphemeralGroupResource creates a new ephemeral group resource
+func NewEphemeralGroupResource() ephemeral.EphemeralResource {
+	return &EphemeralGroupResource{}
+}
+
+// Metadata returns the ephemeral resource type name
+func (e *EphemeralGroupResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+// Schema defines the schema for the ephemeral resource
+func (e *EphemeralGroupResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all secrets from a Vezor group without persisting them to state. Requires Terraform 1.10+.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the group to fetch secrets from.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the group.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the group.",
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "The tags that define this group's query.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"secrets": schema.MapAttribute{
+				Description: "A map of secret names to their decrypted values. Never written to state.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"secret_count": schema.Int64Attribute{
+				Description: "The number of secrets in this group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource
+func (e *EphemeralGroupResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open fetches the group's secrets and returns them only for the duration of the Terraform operation
+func (e *EphemeralGroupResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupName := data.Name.ValueString()
+
+	group, err := e.client.GetGroup(groupName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Open Group",
+			fmt.Sprintf("Unable to read group '%s': %s", groupName, err.Error()),
+		)
+		return
+	}
+
+	groupSecrets, err := e.client.PullGroupSecrets(groupName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Open Group",
+			fmt.Sprintf("Unable to pull secrets for group '%s': %s", groupName, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(group.ID)
+	data.Description = types.StringValue(group.Description)
+	data.SecretCount = types.Int64Value(int64(groupSecrets.Count))
+
+	tagsMap, diags := types.MapValueFrom(ctx, types.StringType, group.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tags = tagsMap
+
+	secretsMap, diags := types.MapValueFrom(ctx, types.StringType, groupSecrets.Secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Secrets = secretsMap
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	private, err := json.Marshal(ephemeralGroupPrivateData{Name: groupName})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Store Ephemeral Private Data",
+			fmt.Sprintf("Unable to encode renew data for group '%s': %s", groupName, err.Error()),
+		)
+		return
+	}
+	resp.Private = private
+	resp.RenewAt = ephemeralRenewAt(groupSecrets.Lease)
+}
+
+// Renew re-pulls the group's secrets before Terraform's cached copy is considered stale
+func (e *EphemeralGroupResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	var private ephemeralGroupPrivateData
+	if err := json.Unmarshal(req.Private, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew Group",
+			fmt.Sprintf("Unable to decode renew data: %s", err.Error()),
+		)
+		return
+	}
+
+	groupSecrets, err := e.client.PullGroupSecrets(private.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew Group",
+			fmt.Sprintf("Unable to refresh group '%s': %s", private.Name, err.Error()),
+		)
+		return
+	}
+
+	resp.Private = req.Private
+	resp.RenewAt = ephemeralRenewAt(groupSecrets.Lease)
+}
+
+// Close is a no-op; the API has no short-lived lease to revoke yet
+func (e *EphemeralGroupResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vezor/terraform-provider-vezor/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResource = &EphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &EphemeralSecretResource{}
+var _ ephemeral.EphemeralResourceWithClose = &EphemeralSecretResource{}
+
+// EphemeralSecretResource defines the ephemeral resource implementation
+type EphemeralSecretResource struct {
+	client *client.Client
+}
+
+// EphemeralSecretResourceModel describes the ephemeral resource data model
+type EphemeralSecretResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Tags        types.Map    `tfsdk:"tags"`
+	ID          types.String `tfsdk:"id"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+	Version     types.Int64  `tfsdk:"version"`
+}
+
+// ephemeralSecretPrivateData is persisted between Open and Renew so Renew can re-pull
+// without the caller re-supplying the config.
+type ephemeralSecretPrivateData struct {
+	Name string            `json:"name"`
+	Tags map[string]string `json:"tags"`
+}
+
+// defaultEphemeralRenewInterval is used when the server returns no lease duration.
+const defaultEphemeralRenewInterval = 5 * time.Minute
+
+// ephemeralRenewAt computes when Terraform should next call Renew: at the lease's duration when
+// the server provides one, otherwise the default interval.
+func ephemeralRenewAt(lease *client.Lease) time.Time {
+	if lease != nil && lease.DurationSeconds > 0 {
+		return time.Now().Add(time.Duration(lease.DurationSeconds) * time.Second)
+	}
+	return time.Now().Add(defaultEphemeralRenewInterval)
+}
+
+// NewEphemeralSecretResource creates a new ephemeral secret resource
+func NewEphemeralSecretResource() ephemeral.EphemeralResource {
+	return &EphemeralSecretResource{}
+}
+
+// Metadata returns the ephemeral resource type name
+func (e *EphemeralSecretResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the schema for the ephemeral resource
+func (e *EphemeralSecretResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single secret from Vezor by name and tags without persisting it to state. Requires Terraform 1.10+.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name (key) of the secret to fetch.",
+				Required:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags to filter the secret. At minimum, 'env' and 'app' are typically required.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the secret.",
+				Computed:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "The decrypted value of the secret. Never written to state.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the secret.",
+				Computed:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "The version number of the secret.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource
+func (e *EphemeralSecretResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open fetches the secret and returns it only for the duration of the Terraform operation
+func (e *EphemeralSecretResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data EphemeralSecretResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make(map[string]string)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := e.client.FindSecret(data.Name.ValueString(), tags, nil, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Open Secret",
+			fmt.Sprintf("Unable to fetch secret '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(secret.ID)
+	data.Value = types.StringValue(secret.Value)
+	data.Description = types.StringValue(secret.Description)
+	data.Version = types.Int64Value(int64(secret.Version))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	private, err := json.Marshal(ephemeralSecretPrivateData{Name: data.Name.ValueString(), Tags: tags})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Store Ephemeral Private Data",
+			fmt.Sprintf("Unable to encode renew data for secret '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+	resp.Private = private
+	resp.RenewAt = ephemeralRenewAt(secret.Lease)
+}
+
+// Renew re-pulls the secret before Terraform's cached copy is considered stale
+func (e *EphemeralSecretResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	var private ephemeralSecretPrivateData
+	if err := json.Unmarshal(req.Private, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew Secret",
+			fmt.Sprintf("Unable to decode renew data: %s", err.Error()),
+		)
+		return
+	}
+
+	secret, err := e.client.FindSecret(private.Name, private.Tags, nil, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Renew Secret",
+			fmt.Sprintf("Unable to refresh secret '%s': %s", private.Name, err.Error()),
+		)
+		return
+	}
+
+	resp.Private = req.Private
+	resp.RenewAt = ephemeralRenewAt(secret.Lease)
+}
+
+// Close is a no-op; the API has no short-lived lease to revoke yet
+func (e *EphemeralSecretResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -20,12 +21,19 @@ type GroupDataSource struct {
 
 // GroupDataSourceModel describes the data source data model
 type GroupDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.Map    `tfsdk:"tags"`
-	Secrets     types.Map    `tfsdk:"secrets"`
-	SecretCount types.Int64  `tfsdk:"secret_count"`
+	ID                   types.String  `tfsdk:"id"`
+	Name                 types.String  `tfsdk:"name"`
+	Description          types.String  `tfsdk:"description"`
+	Tags                 types.Map     `tfsdk:"tags"`
+	Secrets              types.Map     `tfsdk:"secrets"`
+	SecretsJSON          types.String  `tfsdk:"secrets_json"`
+	JSONDecodeValues     types.Bool    `tfsdk:"json_decode_values"`
+	SecretsStructured    types.Dynamic `tfsdk:"secrets_structured"`
+	SecretCount          types.Int64   `tfsdk:"secret_count"`
+	LeaseID              types.String  `tfsdk:"lease_id"`
+	LeaseDurationSeconds types.Int64   `tfsdk:"lease_duration_seconds"`
+	LeaseStartTime       types.String  `tfsdk:"lease_start_time"`
+	LeaseRenewable       types.Bool    `tfsdk:"lease_renewable"`
 }
 
 // NewGroupDataSource creates a new group data source
@@ -67,10 +75,40 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Sensitive:   true,
 				ElementType: types.StringType,
 			},
+			"secrets_json": schema.StringAttribute{
+				Description: "The 'secrets' map encoded as a JSON string, for passing the whole group into jsondecode() without N calls to tomap().",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"json_decode_values": schema.BoolAttribute{
+				Description: "If true, parse each secret value as JSON and expose the result via 'secrets_structured'.",
+				Optional:    true,
+			},
+			"secrets_structured": schema.DynamicAttribute{
+				Description: "Secret values parsed as JSON and keyed by secret name, e.g. secrets_structured[\"config\"].host. Only populated when 'json_decode_values' is true.",
+				Computed:    true,
+				Sensitive:   true,
+			},
 			"secret_count": schema.Int64Attribute{
 				Description: "The number of secrets in this group.",
 				Computed:    true,
 			},
+			"lease_id": schema.StringAttribute{
+				Description: "The identifier of the lease backing this read, if the server issues one.",
+				Computed:    true,
+			},
+			"lease_duration_seconds": schema.Int64Attribute{
+				Description: "How long, in seconds, this read may be cached before it is considered stale.",
+				Computed:    true,
+			},
+			"lease_start_time": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the lease began.",
+				Computed:    true,
+			},
+			"lease_renewable": schema.BoolAttribute{
+				Description: "Whether the lease backing this read can be renewed.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -148,6 +186,48 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 	data.Secrets = secretsMap
 
+	// Encode the secrets map as a JSON string for jsondecode()-style consumption
+	secretsJSON, err := json.Marshal(groupSecrets.Secrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Encode Secrets JSON",
+			fmt.Sprintf("Unable to encode secrets for group '%s' as JSON: %s", groupName, err.Error()),
+		)
+		return
+	}
+	data.SecretsJSON = types.StringValue(string(secretsJSON))
+
+	// Optionally decode each secret value as JSON into a dynamic, indexable structure
+	if data.JSONDecodeValues.ValueBool() {
+		structured := make(map[string]interface{}, len(groupSecrets.Secrets))
+		for name, raw := range groupSecrets.Secrets {
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+				parsed = raw
+			}
+			structured[name] = parsed
+		}
+
+		structuredValue, err := dynamicValueFromJSON(structured)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Decode Secrets JSON",
+				fmt.Sprintf("Unable to decode secrets for group '%s' as structured JSON: %s", groupName, err.Error()),
+			)
+			return
+		}
+		data.SecretsStructured = structuredValue
+	} else {
+		data.SecretsStructured = types.DynamicNull()
+	}
+
+	// Surface lease metadata so operators can reason about freshness
+	lease := leaseAttributesFromLease(groupSecrets.Lease)
+	data.LeaseID = lease.ID
+	data.LeaseDurationSeconds = lease.DurationSeconds
+	data.LeaseStartTime = lease.StartTime
+	data.LeaseRenewable = lease.Renewable
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vezor/terraform-provider-vezor/internal/client"
+)
+
+// leaseAttributes holds the lease_id/lease_duration_seconds/lease_start_time/lease_renewable
+// values reported identically by the secret and group data sources.
+type leaseAttributes struct {
+	ID              types.String
+	DurationSeconds types.Int64
+	StartTime       types.String
+	Renewable       types.Bool
+}
+
+// leaseAttributesFromLease converts a client.Lease into its Terraform representation, falling
+// back to empty/zero values when the server returned no lease.
+func leaseAttributesFromLease(lease *client.Lease) leaseAttributes {
+	if lease == nil {
+		return leaseAttributes{
+			ID:              types.StringValue(""),
+			DurationSeconds: types.Int64Value(0),
+			StartTime:       types.StringValue(""),
+			Renewable:       types.BoolValue(false),
+		}
+	}
+
+	return leaseAttributes{
+		ID:              types.StringValue(lease.ID),
+		DurationSeconds: types.Int64Value(int64(lease.DurationSeconds)),
+		StartTime:       types.StringValue(lease.StartTime),
+		Renewable:       types.BoolValue(lease.Renewable),
+	}
+}
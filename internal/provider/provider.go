@@ -3,17 +3,23 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vezor/terraform-provider-vezor/internal/client"
 )
 
 // Ensure VezorProvider satisfies various provider interfaces
 var _ provider.Provider = &VezorProvider{}
+var _ provider.ProviderWithEphemeralResources = &VezorProvider{}
 
 // VezorProvider defines the provider implementation
 type VezorProvider struct {
@@ -22,8 +28,13 @@ type VezorProvider struct {
 
 // VezorProviderModel describes the provider data model
 type VezorProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
-	APIURL types.String `tfsdk:"api_url"`
+	APIKey          types.String  `tfsdk:"api_key"`
+	APIURL          types.String  `tfsdk:"api_url"`
+	MaxRetries      types.Int64   `tfsdk:"max_retries"`
+	RetryMinBackoff types.Int64   `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff types.Int64   `tfsdk:"retry_max_backoff"`
+	RequestTimeout  types.Int64   `tfsdk:"request_timeout"`
+	RateLimitQPS    types.Float64 `tfsdk:"rate_limit_qps"`
 }
 
 // New creates a new provider instance
@@ -55,10 +66,53 @@ func (p *VezorProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Description: "The URL of the Vezor API. Defaults to https://api.vezor.io. Can also be set via the VEZOR_API_URL environment variable.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries for transient failures (network errors, 5xx, 429, 408, 425). Defaults to 3; set to 0 to disable retries entirely. Can also be set via VEZOR_MAX_RETRIES.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.Int64Attribute{
+				Description: "Minimum backoff, in seconds, before the first retry. Defaults to 0.5 seconds when unset. Can also be set via VEZOR_RETRY_MIN_BACKOFF.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				Description: "Maximum backoff, in seconds, between retries. Defaults to 30. Can also be set via VEZOR_RETRY_MAX_BACKOFF.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Per-request HTTP timeout, in seconds. Defaults to 30. Can also be set via VEZOR_REQUEST_TIMEOUT.",
+				Optional:    true,
+			},
+			"rate_limit_qps": schema.Float64Attribute{
+				Description: "Maximum sustained requests per second to the Vezor API. Unset or 0 disables rate limiting. Can also be set via VEZOR_RATE_LIMIT_QPS.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+// intFromEnvOrConfig resolves an int64 provider setting from config first, falling back to an
+// environment variable, and returns ok=false if neither is set.
+func intFromEnvOrConfig(configValue types.Int64, envVar string) (int64, bool) {
+	if !configValue.IsNull() {
+		return configValue.ValueInt64(), true
+	}
+	if raw := os.Getenv(envVar); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return parsed, true
+		}
+	}
+	return 0, false
+}
+
 // Configure prepares a Vezor API client for data sources and resources
 func (p *VezorProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config VezorProviderModel
@@ -91,18 +145,45 @@ func (p *VezorProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		apiURL = config.APIURL.ValueString()
 	}
 
+	opts := client.ClientOptions{}
+	if maxRetries, ok := intFromEnvOrConfig(config.MaxRetries, "VEZOR_MAX_RETRIES"); ok {
+		v := int(maxRetries)
+		opts.MaxRetries = &v
+	}
+	if minBackoff, ok := intFromEnvOrConfig(config.RetryMinBackoff, "VEZOR_RETRY_MIN_BACKOFF"); ok {
+		v := time.Duration(minBackoff) * time.Second
+		opts.RetryMinBackoff = &v
+	}
+	if maxBackoff, ok := intFromEnvOrConfig(config.RetryMaxBackoff, "VEZOR_RETRY_MAX_BACKOFF"); ok {
+		v := time.Duration(maxBackoff) * time.Second
+		opts.RetryMaxBackoff = &v
+	}
+	if timeout, ok := intFromEnvOrConfig(config.RequestTimeout, "VEZOR_REQUEST_TIMEOUT"); ok {
+		v := time.Duration(timeout) * time.Second
+		opts.RequestTimeout = &v
+	}
+	if !config.RateLimitQPS.IsNull() {
+		v := config.RateLimitQPS.ValueFloat64()
+		opts.RateLimitQPS = &v
+	} else if raw := os.Getenv("VEZOR_RATE_LIMIT_QPS"); raw != "" {
+		if parsed, err := strconv.ParseFloat(raw, 64); err == nil {
+			opts.RateLimitQPS = &parsed
+		}
+	}
+
 	// Create the API client
-	vezorClient := client.NewClient(apiURL, apiKey)
+	vezorClient := client.NewClient(apiURL, apiKey, opts)
 
 	// Make the client available to data sources and resources
 	resp.DataSourceData = vezorClient
 	resp.ResourceData = vezorClient
+	resp.EphemeralResourceData = vezorClient
 }
 
 // Resources defines the resources implemented in the provider
 func (p *VezorProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		// Resources would go here if we implement secret management
+		NewSecretResource,
 	}
 }
 
@@ -111,5 +192,14 @@ func (p *VezorProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		NewSecretDataSource,
 		NewGroupDataSource,
+		NewSecretsDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider
+func (p *VezorProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralSecretResource,
+		NewEphemeralGroupResource,
 	}
 }
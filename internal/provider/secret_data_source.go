@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vezor/terraform-provider-vezor/internal/client"
 )
@@ -20,12 +24,17 @@ type SecretDataSource struct {
 
 // SecretDataSourceModel describes the data source data model
 type SecretDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Value       types.String `tfsdk:"value"`
-	Description types.String `tfsdk:"description"`
-	Tags        types.Map    `tfsdk:"tags"`
-	Version     types.Int64  `tfsdk:"version"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Value                types.String `tfsdk:"value"`
+	Description          types.String `tfsdk:"description"`
+	Tags                 types.Map    `tfsdk:"tags"`
+	Version              types.Int64  `tfsdk:"version"`
+	VersionAlias         types.String `tfsdk:"version_alias"`
+	LeaseID              types.String `tfsdk:"lease_id"`
+	LeaseDurationSeconds types.Int64  `tfsdk:"lease_duration_seconds"`
+	LeaseStartTime       types.String `tfsdk:"lease_start_time"`
+	LeaseRenewable       types.Bool   `tfsdk:"lease_renewable"`
 }
 
 // NewSecretDataSource creates a new secret data source
@@ -67,7 +76,34 @@ func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				ElementType: types.StringType,
 			},
 			"version": schema.Int64Attribute{
-				Description: "The version number of the secret.",
+				Description: "Pin the secret to a specific version number. If omitted, the latest version is returned. Reflects the resolved version on read. Conflicts with 'version_alias'.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("version_alias")),
+				},
+			},
+			"version_alias": schema.StringAttribute{
+				Description: "Pin the secret to a named version alias, e.g. 'stable' or 'latest'. Conflicts with 'version'.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version")),
+				},
+			},
+			"lease_id": schema.StringAttribute{
+				Description: "The identifier of the lease backing this read, if the server issues one.",
+				Computed:    true,
+			},
+			"lease_duration_seconds": schema.Int64Attribute{
+				Description: "How long, in seconds, this read may be cached before it is considered stale.",
+				Computed:    true,
+			},
+			"lease_start_time": schema.StringAttribute{
+				Description: "The RFC 3339 timestamp at which the lease began.",
+				Computed:    true,
+			},
+			"lease_renewable": schema.BoolAttribute{
+				Description: "Whether the lease backing this read can be renewed.",
 				Computed:    true,
 			},
 		},
@@ -110,8 +146,15 @@ func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// Resolve an optional pinned version
+	var version *int
+	if !data.Version.IsNull() {
+		v := int(data.Version.ValueInt64())
+		version = &v
+	}
+
 	// Fetch the secret from the API
-	secret, err := d.client.FindSecret(data.Name.ValueString(), tags)
+	secret, err := d.client.FindSecret(data.Name.ValueString(), tags, version, data.VersionAlias.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Secret",
@@ -135,6 +178,13 @@ func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 	data.Tags = tagsMap
 
+	// Surface lease metadata so operators can reason about freshness
+	lease := leaseAttributesFromLease(secret.Lease)
+	data.LeaseID = lease.ID
+	data.LeaseDurationSeconds = lease.DurationSeconds
+	data.LeaseStartTime = lease.StartTime
+	data.LeaseRenewable = lease.Renewable
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
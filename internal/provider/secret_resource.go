@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vezor/terraform-provider-vezor/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SecretResource{}
+var _ resource.ResourceWithImportState = &SecretResource{}
+
+// SecretResource defines the resource implementation
+type SecretResource struct {
+	client *client.Client
+}
+
+// SecretResourceModel describes the resource data model
+type SecretResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	Description    types.String `tfsdk:"description"`
+	Tags           types.Map    `tfsdk:"tags"`
+	Version        types.Int64  `tfsdk:"version"`
+	VersionAliases types.Map    `tfsdk:"version_aliases"`
+}
+
+// NewSecretResource creates a new secret resource
+func NewSecretResource() resource.Resource {
+	return &SecretResource{}
+}
+
+// Metadata returns the resource type name
+func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the schema for the resource
+func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a secret in Vezor.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the secret.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name (key) of the secret. Changing this forces replacement; there is no rename API.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the secret. Updating this creates a new version.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the secret. Defaults to the server's value when omitted.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Tags attached to the secret. Defaults to the server's value when omitted.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"version": schema.Int64Attribute{
+				Description: "The current version number of the secret.",
+				Computed:    true,
+			},
+			"version_aliases": schema.MapAttribute{
+				Description: "A map of alias name to version number, e.g. {\"latest\":\"7\",\"stable\":\"5\"}. Updating an alias points it at an existing version and does not itself create a new version. Defaults to the server's value when omitted.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make(map[string]string)
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	secret, err := r.client.CreateSecret(data.Name.ValueString(), data.Value.ValueString(), data.Description.ValueString(), tags)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Secret",
+			fmt.Sprintf("Unable to create secret '%s': %s", data.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyVersionAliases(ctx, secret, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(mapSecretToResourceModel(ctx, secret, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.GetSecret(data.ID.ValueString(), nil, "")
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Read Secret",
+			fmt.Sprintf("Unable to read secret '%s': %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapSecretToResourceModel(ctx, secret, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state
+func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := make(map[string]string)
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	stateTags := make(map[string]string)
+	if !state.Tags.IsNull() {
+		resp.Diagnostics.Append(state.Tags.ElementsAs(ctx, &stateTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A description or tags omitted from config plans as unknown; keep the current values
+	// for it instead of treating it as a change to empty.
+	if data.Tags.IsUnknown() {
+		tags = stateTags
+	}
+	description := data.Description.ValueString()
+	if data.Description.IsUnknown() {
+		description = state.Description.ValueString()
+	}
+
+	var secret *client.Secret
+	var err error
+	if data.Value.ValueString() != state.Value.ValueString() ||
+		description != state.Description.ValueString() ||
+		!stringMapsEqual(tags, stateTags) {
+		// Only a value/description/tags change warrants a new version; repointing an alias
+		// below must not bump the secret's version as a side effect.
+		secret, err = r.client.UpdateSecret(state.ID.ValueString(), data.Value.ValueString(), description, tags)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Secret",
+				fmt.Sprintf("Unable to update secret '%s': %s", state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	} else {
+		secret, err = r.client.GetSecret(state.ID.ValueString(), nil, "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Secret",
+				fmt.Sprintf("Unable to read secret '%s': %s", state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(r.applyVersionAliases(ctx, secret, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(mapSecretToResourceModel(ctx, secret, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource
+func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSecret(data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Secret",
+			fmt.Sprintf("Unable to delete secret '%s': %s", data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing secret by ID
+func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyVersionAliases reconciles the planned version_aliases against the server, pointing each
+// alias at the requested version via a dedicated call rather than bundling it into the secret update.
+func (r *SecretResource) applyVersionAliases(ctx context.Context, secret *client.Secret, data *SecretResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.VersionAliases.IsNull() || data.VersionAliases.IsUnknown() {
+		return diags
+	}
+
+	aliases := make(map[string]string)
+	diags.Append(data.VersionAliases.ElementsAs(ctx, &aliases, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for alias, versionStr := range aliases {
+		version, err := strconv.Atoi(versionStr)
+		if err != nil {
+			diags.AddError(
+				"Invalid Version Alias",
+				fmt.Sprintf("Alias '%s' has an invalid version '%s': %s", alias, versionStr, err.Error()),
+			)
+			continue
+		}
+
+		updated, err := r.client.SetVersionAlias(secret.ID, alias, version)
+		if err != nil {
+			diags.AddError(
+				"Unable to Set Version Alias",
+				fmt.Sprintf("Unable to point alias '%s' at version %d: %s", alias, version, err.Error()),
+			)
+			continue
+		}
+		secret.VersionAliases = updated
+	}
+
+	return diags
+}
+
+// mapSecretToResourceModel maps an API secret onto the Terraform resource model. description,
+// tags, and version_aliases are Optional+Computed, so on Create/Update (refresh=false) a known
+// planned value is left untouched and only an unknown one (config omitted it) is filled from the
+// server; otherwise Terraform sees the state diverge from what it planned and errors. Read
+// (refresh=true) always overwrites them from the server so drift is detected.
+func mapSecretToResourceModel(ctx context.Context, secret *client.Secret, data *SecretResourceModel, refresh bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(secret.ID)
+	data.Name = types.StringValue(secret.KeyName)
+	data.Value = types.StringValue(secret.Value)
+	data.Version = types.Int64Value(int64(secret.Version))
+
+	if refresh || data.Description.IsUnknown() {
+		data.Description = types.StringValue(secret.Description)
+	}
+
+	if refresh || data.Tags.IsUnknown() {
+		tagsMap, tagDiags := types.MapValueFrom(ctx, types.StringType, secret.Tags)
+		diags.Append(tagDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.Tags = tagsMap
+	}
+
+	if refresh || data.VersionAliases.IsUnknown() {
+		aliasesMap, aliasDiags := types.MapValueFrom(ctx, types.StringType, secret.VersionAliases)
+		diags.Append(aliasDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.VersionAliases = aliasesMap
+	}
+
+	return diags
+}
+
+// stringMapsEqual reports whether two string maps contain the same keys and values.
+func stringMapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
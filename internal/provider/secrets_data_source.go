@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vezor/terraform-provider-vezor/internal/client"
+)
+
+// fetchValuesWorkerPoolSize bounds how many concurrent GETs SecretsDataSource issues when
+// fetch_values is true, so a large tag query doesn't open hundreds of connections at once.
+const fetchValuesWorkerPoolSize = 8
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SecretsDataSource{}
+
+// SecretsDataSource defines the data source implementation
+type SecretsDataSource struct {
+	client *client.Client
+}
+
+// SecretsDataSourceModel describes the data source data model
+type SecretsDataSourceModel struct {
+	TagFilter   types.Map                    `tfsdk:"tag_filter"`
+	NamePrefix  types.String                 `tfsdk:"name_prefix"`
+	NameRegex   types.String                 `tfsdk:"name_regex"`
+	Limit       types.Int64                  `tfsdk:"limit"`
+	KeyTemplate types.String                 `tfsdk:"key_template"`
+	FetchValues types.Bool                   `tfsdk:"fetch_values"`
+	Secrets     types.Map                    `tfsdk:"secrets"`
+	Items       []SecretsDataSourceItemModel `tfsdk:"items"`
+}
+
+// SecretsDataSourceItemModel describes a single entry in the computed "items" list
+type SecretsDataSourceItemModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Tags    types.Map    `tfsdk:"tags"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+// NewSecretsDataSource creates a new plural secrets data source
+func NewSecretsDataSource() datasource.DataSource {
+	return &SecretsDataSource{}
+}
+
+// Metadata returns the data source type name
+func (d *SecretsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+// Schema defines the schema for the data source
+func (d *SecretsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a set of secrets matching a tag query, without requiring a pre-existing server-side group.",
+
+		Attributes: map[string]schema.Attribute{
+			"tag_filter": schema.MapAttribute{
+				Description: "Tags that matching secrets must carry.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only include secrets whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include secrets whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of matching secrets to return.",
+				Optional:    true,
+			},
+			"key_template": schema.StringAttribute{
+				Description: "A text/template string used to derive each secret's key in 'secrets', e.g. '{{.Tags.app}}_{{.Name}}'. Defaults to '{{.Name}}'.",
+				Optional:    true,
+			},
+			"fetch_values": schema.BoolAttribute{
+				Description: "If true, concurrently fetch each matched secret's decrypted value into 'secrets'. Defaults to false to avoid N extra GETs when only metadata is needed.",
+				Optional:    true,
+			},
+			"secrets": schema.MapAttribute{
+				Description: "A map of rendered key_template to decrypted secret value. Only populated when 'fetch_values' is true.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Metadata for every matched secret, without fetching values.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the secret.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name (key) of the secret.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Tags attached to the secret.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"version": schema.Int64Attribute{
+							Description: "The current version number of the secret.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *SecretsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// secretKeyTemplateData is the data passed to the key_template
+type secretKeyTemplateData struct {
+	ID      string
+	Name    string
+	Tags    map[string]string
+	Version int
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagFilter := make(map[string]string)
+	if !data.TagFilter.IsNull() {
+		resp.Diagnostics.Append(data.TagFilter.ElementsAs(ctx, &tagFilter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if pattern := data.NameRegex.ValueString(); pattern != "" {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid name_regex",
+				fmt.Sprintf("Unable to compile regular expression '%s': %s", pattern, err.Error()),
+			)
+			return
+		}
+		nameRegex = compiled
+	}
+
+	keyTemplateSource := data.KeyTemplate.ValueString()
+	if keyTemplateSource == "" {
+		keyTemplateSource = "{{.Name}}"
+	}
+	keyTemplate, err := template.New("key_template").Parse(keyTemplateSource)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid key_template",
+			fmt.Sprintf("Unable to parse key_template: %s", err.Error()),
+		)
+		return
+	}
+
+	listResp, err := d.client.ListSecrets(tagFilter, "", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Secrets",
+			fmt.Sprintf("Unable to list secrets: %s", err.Error()),
+		)
+		return
+	}
+
+	namePrefix := data.NamePrefix.ValueString()
+	matched := make([]client.Secret, 0, len(listResp.Secrets))
+	for _, s := range listResp.Secrets {
+		if namePrefix != "" && !strings.HasPrefix(s.KeyName, namePrefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(s.KeyName) {
+			continue
+		}
+		matched = append(matched, s)
+	}
+
+	if limit := data.Limit.ValueInt64(); limit > 0 && int64(len(matched)) > limit {
+		matched = matched[:limit]
+	}
+
+	items := make([]SecretsDataSourceItemModel, len(matched))
+	keys := make([]string, len(matched))
+	for i, s := range matched {
+		tagsMap, diags := types.MapValueFrom(ctx, types.StringType, s.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		items[i] = SecretsDataSourceItemModel{
+			ID:      types.StringValue(s.ID),
+			Name:    types.StringValue(s.KeyName),
+			Tags:    tagsMap,
+			Version: types.Int64Value(int64(s.Version)),
+		}
+
+		var rendered bytes.Buffer
+		if err := keyTemplate.Execute(&rendered, secretKeyTemplateData{
+			ID:      s.ID,
+			Name:    s.KeyName,
+			Tags:    s.Tags,
+			Version: s.Version,
+		}); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Render key_template",
+				fmt.Sprintf("Unable to render key_template for secret '%s': %s", s.KeyName, err.Error()),
+			)
+			return
+		}
+		keys[i] = rendered.String()
+	}
+	data.Items = items
+
+	if data.FetchValues.ValueBool() {
+		values, err := d.fetchValues(matched, keys)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Fetch Secret Values",
+				err.Error(),
+			)
+			return
+		}
+
+		secretsMap, diags := types.MapValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Secrets = secretsMap
+	} else {
+		emptyMap, diags := types.MapValueFrom(ctx, types.StringType, map[string]string{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Secrets = emptyMap
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchValues resolves the decrypted value for each matched secret using a bounded worker pool,
+// keyed by the already-rendered key_template value at the same index.
+func (d *SecretsDataSource) fetchValues(secrets []client.Secret, keys []string) (map[string]string, error) {
+	type result struct {
+		key   string
+		value string
+		err   error
+	}
+
+	jobs := make(chan int)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for w := 0; w < fetchValuesWorkerPoolSize; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				secret, err := d.client.GetSecret(secrets[i].ID, nil, "")
+				if err != nil {
+					results <- result{err: fmt.Errorf("unable to fetch value for secret '%s': %w", secrets[i].KeyName, err)}
+					continue
+				}
+				results <- result{key: keys[i], value: secret.Value}
+			}
+		}()
+	}
+
+	go func() {
+		for i := range secrets {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	values := make(map[string]string, len(secrets))
+	var firstErr error
+	for r := range results {
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = r.err
+			}
+			continue
+		}
+		values[r.key] = r.value
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return values, nil
+}